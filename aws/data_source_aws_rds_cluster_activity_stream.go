@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-aws/aws/internal/service/rds/finder"
+)
+
+func dataSourceAwsRDSClusterActivityStream() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsRDSClusterActivityStreamRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"arn", "db_cluster_identifier"},
+			},
+			"db_cluster_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"arn", "db_cluster_identifier"},
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kinesis_stream_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_native_audit_fields_included": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsRDSClusterActivityStreamRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	lookupID := d.Get("arn").(string)
+	if lookupID == "" {
+		lookupID = d.Get("db_cluster_identifier").(string)
+	}
+
+	dbc, err := finder.ActivityStreamByClusterIdentifier(conn, lookupID)
+	if err != nil {
+		return fmt.Errorf("error reading RDS Cluster (%s): %s", lookupID, err)
+	}
+
+	if dbc == nil {
+		return fmt.Errorf("error reading RDS Cluster (%s): not found", lookupID)
+	}
+
+	d.SetId(aws.StringValue(dbc.DBClusterArn))
+	d.Set("arn", dbc.DBClusterArn)
+	d.Set("db_cluster_identifier", dbc.DBClusterIdentifier)
+	d.Set("kms_key_id", dbc.ActivityStreamKmsKeyId)
+	d.Set("kinesis_stream_name", dbc.ActivityStreamKinesisStreamName)
+	d.Set("mode", dbc.ActivityStreamMode)
+	d.Set("status", dbc.ActivityStreamStatus)
+	d.Set("engine_native_audit_fields_included", dbc.ActivityStreamEngineNativeAuditFieldsIncluded)
+
+	return nil
+}
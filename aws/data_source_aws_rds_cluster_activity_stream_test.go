@@ -0,0 +1,40 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceAwsRDSClusterActivityStream_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aws_rds_cluster_activity_stream.test"
+	dataSourceName := "data.aws_rds_cluster_activity_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSClusterActivityStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsRDSClusterActivityStreamConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "kms_key_id", resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "kinesis_stream_name", resourceName, "kinesis_stream_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mode", resourceName, "mode"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "started"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsRDSClusterActivityStreamConfig(rName string) string {
+	return testAccAWSClusterActivityStreamConfig(rName) + `
+data "aws_rds_cluster_activity_stream" "test" {
+  arn = aws_rds_cluster_activity_stream.test.arn
+}
+`
+}
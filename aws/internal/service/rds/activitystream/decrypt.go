@@ -0,0 +1,98 @@
+// Package activitystream decrypts the encrypted record format that RDS
+// Database Activity Streams write to their Kinesis stream: the
+// aws-encryption-sdk envelope of a KMS-wrapped data key plus an AES-GCM
+// payload.
+//
+// aws_rds_cluster_activity_stream_consumer registers the Kinesis enhanced
+// fan-out consumer that a record ends up being read through, but decrypting
+// individual records happens at read time in whatever application
+// subscribes as that consumer, not in the Terraform provider -- Decrypt is
+// the primitive that application calls. The rest of the originally
+// requested consumer subsystem (per-shard DynamoDB checkpointing, event
+// filtering, delivery to CloudWatch Logs/S3/Firehose) is data-plane work
+// for that same application, is not implemented here, and needs to go back
+// to the requester for discussion rather than being cut unilaterally.
+package activitystream
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+// Record mirrors the JSON envelope RDS writes to the activity stream Kinesis
+// stream: a base64-encoded, KMS-wrapped data key and a base64-encoded
+// payload encrypted with that data key under AES-GCM.
+type Record struct {
+	Type                      string `json:"type"`
+	DatabaseActivityEventList string `json:"databaseActivityEventList"`
+	Key                       string `json:"key"`
+}
+
+// Decrypt performs the two-stage decrypt described by the Database Activity
+// Streams documentation: it asks KMS to decrypt the per-record data key,
+// then uses that plaintext data key to AES-GCM decrypt the event payload.
+// It returns the plaintext JSON document describing the database activity
+// events contained in the record.
+func Decrypt(conn kmsiface.KMSAPI, record Record) ([]byte, error) {
+	encryptedKey, err := base64.StdEncoding.DecodeString(record.Key)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding activity stream record key: %w", err)
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(record.DatabaseActivityEventList)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding activity stream record payload: %w", err)
+	}
+
+	decryptOutput, err := conn.Decrypt(&kms.DecryptInput{
+		CiphertextBlob: encryptedKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting activity stream data key: %w", err)
+	}
+
+	plaintext, err := decryptGCM(decryptOutput.Plaintext, payload)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting activity stream record payload: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// decryptGCM decrypts payload, which is expected to be a 12-byte GCM nonce
+// followed by the ciphertext and authentication tag, using the given
+// plaintext AES data key.
+func decryptGCM(dataKey, payload []byte) ([]byte, error) {
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := gcm.NonceSize()
+	if len(payload) < nonceSize {
+		return nil, fmt.Errorf("activity stream record payload is shorter than the GCM nonce")
+	}
+
+	nonce, ciphertext := payload[:nonceSize], payload[nonceSize:]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+// ParseRecord unmarshals a raw Kinesis record into a Record envelope.
+func ParseRecord(data []byte) (Record, error) {
+	var record Record
+	if err := json.Unmarshal(data, &record); err != nil {
+		return Record{}, fmt.Errorf("error parsing activity stream record: %w", err)
+	}
+	return record, nil
+}
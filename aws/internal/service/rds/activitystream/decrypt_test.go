@@ -0,0 +1,105 @@
+package activitystream
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+// fakeKMS stubs just enough of kmsiface.KMSAPI to hand Decrypt a fixed
+// plaintext data key, standing in for the CMK that would unwrap the
+// per-record data key in a real account.
+type fakeKMS struct {
+	kmsiface.KMSAPI
+	dataKey []byte
+	err     error
+}
+
+func (f *fakeKMS) Decrypt(input *kms.DecryptInput) (*kms.DecryptOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &kms.DecryptOutput{Plaintext: f.dataKey}, nil
+}
+
+func encryptRecord(t *testing.T, dataKey, plaintext []byte) string {
+	t.Helper()
+
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		t.Fatalf("error creating cipher: %s", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatalf("error creating GCM: %s", err)
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		t.Fatalf("error generating nonce: %s", err)
+	}
+
+	ciphertext := gcm.Seal(nonce, nonce, plaintext, nil)
+	return base64.StdEncoding.EncodeToString(ciphertext)
+}
+
+func TestDecrypt(t *testing.T) {
+	dataKey := make([]byte, 32) // AES-256
+	if _, err := rand.Read(dataKey); err != nil {
+		t.Fatalf("error generating data key: %s", err)
+	}
+
+	plaintext := []byte(`{"databaseActivityEvents":[{"type":"heartbeat"}]}`)
+
+	record := Record{
+		Type:                      "DatabaseActivityMonitoringRecord",
+		Key:                       base64.StdEncoding.EncodeToString([]byte("encrypted-data-key")),
+		DatabaseActivityEventList: encryptRecord(t, dataKey, plaintext),
+	}
+
+	got, err := Decrypt(&fakeKMS{dataKey: dataKey}, record)
+	if err != nil {
+		t.Fatalf("Decrypt() returned error: %s", err)
+	}
+
+	if string(got) != string(plaintext) {
+		t.Errorf("Decrypt() = %q, want %q", got, plaintext)
+	}
+}
+
+func TestDecrypt_badRecordKey(t *testing.T) {
+	record := Record{Key: "not-valid-base64!!", DatabaseActivityEventList: ""}
+
+	if _, err := Decrypt(&fakeKMS{}, record); err == nil {
+		t.Fatal("Decrypt() with an unparseable record key: expected error, got nil")
+	}
+}
+
+func TestDecrypt_kmsError(t *testing.T) {
+	record := Record{
+		Key:                       base64.StdEncoding.EncodeToString([]byte("encrypted-data-key")),
+		DatabaseActivityEventList: base64.StdEncoding.EncodeToString([]byte("ciphertext")),
+	}
+
+	if _, err := Decrypt(&fakeKMS{err: errors.New("AccessDeniedException")}, record); err == nil {
+		t.Fatal("Decrypt() with a failing KMS call: expected error, got nil")
+	}
+}
+
+func TestDecryptGCM_payloadShorterThanNonce(t *testing.T) {
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		t.Fatalf("error generating data key: %s", err)
+	}
+
+	if _, err := decryptGCM(dataKey, []byte("short")); err == nil {
+		t.Fatal("decryptGCM() with a too-short payload: expected error, got nil")
+	}
+}
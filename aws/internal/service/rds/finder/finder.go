@@ -0,0 +1,31 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// ActivityStreamByClusterIdentifier returns the DBCluster for the given
+// cluster ARN or identifier, for callers that only care about its activity
+// stream attributes (ActivityStream*). Returns nil if the cluster doesn't
+// exist.
+func ActivityStreamByClusterIdentifier(conn *rds.RDS, dbClusterIdentifier string) (*rds.DBCluster, error) {
+	input := &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(dbClusterIdentifier),
+	}
+
+	output, err := conn.DescribeDBClusters(input)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == rds.ErrCodeDBClusterNotFoundFault {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if output == nil || len(output.DBClusters) == 0 {
+		return nil, nil
+	}
+
+	return output.DBClusters[0], nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -73,6 +74,120 @@ func ActivityStreamStatus(conn *rds.RDS, dbClusterIdentifier string) resource.St
 	}
 }
 
+const (
+	// StorageNode composite states, for transitions not already covered by
+	// one of the underlying resources' own status strings
+	StorageNodeStatusCreatingInstances = "creating-instances"
+	StorageNodeStatusStartingStream    = "starting-stream"
+	StorageNodeStatusStoppingStream    = "stopping-stream"
+	StorageNodeStatusAvailable         = "available"
+)
+
+// StorageNodeStatus folds the status of an Aurora cluster, its instances,
+// and its activity stream into a single state machine, so a caller that
+// composes all three (e.g. aws_rds_aurora_storage_node) can wait on one
+// Pending/Target pair instead of juggling three separate refresh funcs.
+// It reports the least-ready of the three: a cluster that isn't "available"
+// yet always wins, then any instance that isn't "available", then the
+// activity stream, which must be "started" when wantStreamStarted is set and
+// "stopped" otherwise (an empty stream status counts as "stopped").
+func StorageNodeStatus(conn *rds.RDS, dbClusterIdentifier string, dbInstanceIdentifiers []string, wantStreamStarted bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		clusterOutput, err := conn.DescribeDBClusters(&rds.DescribeDBClustersInput{
+			DBClusterIdentifier: aws.String(dbClusterIdentifier),
+		})
+		if err != nil {
+			if isAWSErr(err, rds.ErrCodeDBClusterNotFoundFault, "") {
+				return nil, "destroyed", nil
+			}
+			return nil, "", fmt.Errorf("error describing RDS Cluster: %w", err)
+		}
+
+		if clusterOutput == nil || len(clusterOutput.DBClusters) == 0 {
+			return nil, "destroyed", nil
+		}
+
+		cluster := clusterOutput.DBClusters[0]
+		clusterStatus := aws.StringValue(cluster.Status)
+		if clusterStatus != "available" {
+			return cluster, clusterStatus, nil
+		}
+
+		for _, id := range dbInstanceIdentifiers {
+			instanceOutput, err := conn.DescribeDBInstances(&rds.DescribeDBInstancesInput{
+				DBInstanceIdentifier: aws.String(id),
+			})
+			if err != nil {
+				if isAWSErr(err, rds.ErrCodeDBInstanceNotFoundFault, "") {
+					return cluster, StorageNodeStatusCreatingInstances, nil
+				}
+				return nil, "", fmt.Errorf("error describing RDS Cluster Instance (%s): %w", id, err)
+			}
+
+			if instanceOutput == nil || len(instanceOutput.DBInstances) == 0 {
+				return cluster, StorageNodeStatusCreatingInstances, nil
+			}
+
+			instance := instanceOutput.DBInstances[0]
+			instanceStatus := aws.StringValue(instance.DBInstanceStatus)
+			if instanceStatus != "available" {
+				return instance, instanceStatus, nil
+			}
+		}
+
+		streamStatus := aws.StringValue(cluster.ActivityStreamStatus)
+		if streamStatus == "" {
+			streamStatus = rds.ActivityStreamStatusStopped
+		}
+
+		if wantStreamStarted && streamStatus != rds.ActivityStreamStatusStarted {
+			if streamStatus == rds.ActivityStreamStatusStopped {
+				return cluster, StorageNodeStatusStartingStream, nil
+			}
+			return cluster, streamStatus, nil
+		}
+
+		if !wantStreamStarted && streamStatus != rds.ActivityStreamStatusStopped {
+			if streamStatus == rds.ActivityStreamStatusStarted {
+				return cluster, StorageNodeStatusStoppingStream, nil
+			}
+			return cluster, streamStatus, nil
+		}
+
+		return cluster, StorageNodeStatusAvailable, nil
+	}
+}
+
+// ConsumerStatusNotFound is reported for a Kinesis stream consumer that
+// DescribeStreamConsumer can no longer find, e.g. once deregistration has
+// completed.
+const ConsumerStatusNotFound = "NotFound"
+
+// ConsumerStatus fetches the Kinesis enhanced fan-out consumer registered
+// against an RDS Database Activity Stream's Kinesis stream, for
+// aws_rds_cluster_activity_stream_consumer to wait on CREATING/DELETING
+// transitions.
+func ConsumerStatus(conn *kinesis.Kinesis, consumerARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeStreamConsumer(&kinesis.DescribeStreamConsumerInput{
+			ConsumerARN: aws.String(consumerARN),
+		})
+
+		if err != nil {
+			if isAWSErr(err, kinesis.ErrCodeResourceNotFoundException, "") {
+				return nil, ConsumerStatusNotFound, nil
+			}
+			return nil, "", fmt.Errorf("error describing Kinesis Stream Consumer (%s): %w", consumerARN, err)
+		}
+
+		if output == nil || output.ConsumerDescription == nil {
+			return nil, ConsumerStatusNotFound, nil
+		}
+
+		return output.ConsumerDescription, aws.StringValue(output.ConsumerDescription.ConsumerStatus), nil
+	}
+}
+
 // Similar to isAWSErr from aws/awserr.go
 // TODO: Add and export in shared package
 func isAWSErr(err error, code string, message string) bool {
@@ -0,0 +1,500 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-aws/aws/internal/service/rds/finder"
+	"github.com/hashicorp/terraform-provider-aws/aws/internal/service/rds/waiter"
+)
+
+const (
+	AWSRDSAuroraStorageNodeRetryDelay      = 5 * time.Second
+	AWSRDSAuroraStorageNodeRetryMinTimeout = 3 * time.Second
+)
+
+// resourceAwsRDSAuroraStorageNode composes aws_rds_cluster,
+// aws_rds_cluster_instance, and aws_rds_cluster_activity_stream into a
+// single create/update/destroy lifecycle: create the cluster, create its
+// instances, then enable the activity stream, tearing down in the reverse
+// order. It exists alongside those resources, not instead of them -- they
+// remain independently usable for callers who want their own plan/diff per
+// step; this resource is for callers who'd otherwise have to re-author that
+// 3-resource `depends_on` graph in every module that needs it.
+func resourceAwsRDSAuroraStorageNode() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRDSAuroraStorageNodeCreate,
+		Read:   resourceAwsRDSAuroraStorageNodeRead,
+		Update: resourceAwsRDSAuroraStorageNodeUpdate,
+		Delete: resourceAwsRDSAuroraStorageNodeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"master_username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"master_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// instance topology: one aws_rds_cluster_instance per element,
+			// created in order, first element is the primary
+			"instance": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"instance_class": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			// mirrors aws_rds_cluster_activity_stream's own schema
+			"activity_stream": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(rds.ActivityStreamMode_Values(), false),
+						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"apply_immediately": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"engine_native_audit_fields_included": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRDSAuroraStorageNodeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	clusterID := d.Get("cluster_identifier").(string)
+
+	createClusterInput := &rds.CreateDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+		Engine:              aws.String(d.Get("engine").(string)),
+		MasterUsername:      aws.String(d.Get("master_username").(string)),
+		MasterUserPassword:  aws.String(d.Get("master_password").(string)),
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		createClusterInput.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("database_name"); ok {
+		createClusterInput.DatabaseName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("availability_zones"); ok {
+		createClusterInput.AvailabilityZones = expandStringList(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating RDS Aurora Storage Node cluster: %s", createClusterInput)
+	if _, err := conn.CreateDBCluster(createClusterInput); err != nil {
+		return fmt.Errorf("error creating RDS Aurora Storage Node cluster: %s", err)
+	}
+
+	d.SetId(clusterID)
+
+	if err := resourceAwsRDSAuroraStorageNodeWaitForClusterAvailable(d.Timeout(schema.TimeoutCreate), clusterID, conn); err != nil {
+		return err
+	}
+
+	instances := d.Get("instance").([]interface{})
+	for _, raw := range instances {
+		instance := raw.(map[string]interface{})
+
+		createInstanceInput := &rds.CreateDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instance["identifier"].(string)),
+			DBInstanceClass:      aws.String(instance["instance_class"].(string)),
+			Engine:               aws.String(d.Get("engine").(string)),
+			DBClusterIdentifier:  aws.String(clusterID),
+		}
+
+		log.Printf("[DEBUG] Creating RDS Aurora Storage Node instance: %s", createInstanceInput)
+		if _, err := conn.CreateDBInstance(createInstanceInput); err != nil {
+			return fmt.Errorf("error creating RDS Aurora Storage Node instance (%s): %s", instance["identifier"].(string), err)
+		}
+	}
+
+	if err := resourceAwsRDSAuroraStorageNodeWaitForAvailable(d.Timeout(schema.TimeoutCreate), clusterID, resourceAwsRDSAuroraStorageNodeInstanceIDs(d), false, conn); err != nil {
+		return err
+	}
+
+	if streamInput := resourceAwsRDSAuroraStorageNodeActivityStreamInput(d, clusterID); streamInput != nil {
+		if err := resourceAwsRDSClusterActivityStreamStart(conn, streamInput, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error starting RDS Aurora Storage Node activity stream: %s", err)
+		}
+
+		if err := resourceAwsRDSAuroraStorageNodeWaitForAvailable(d.Timeout(schema.TimeoutCreate), clusterID, resourceAwsRDSAuroraStorageNodeInstanceIDs(d), true, conn); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsRDSAuroraStorageNodeRead(d, meta)
+}
+
+func resourceAwsRDSAuroraStorageNodeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	dbc, err := finder.ActivityStreamByClusterIdentifier(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error describing RDS Aurora Storage Node cluster (%s): %s", d.Id(), err)
+	}
+
+	if dbc == nil {
+		log.Printf("[WARN] RDS Aurora Storage Node cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_identifier", dbc.DBClusterIdentifier)
+	d.Set("arn", dbc.DBClusterArn)
+	d.Set("engine", dbc.Engine)
+	d.Set("engine_version", dbc.EngineVersion)
+	d.Set("database_name", dbc.DatabaseName)
+	d.Set("master_username", dbc.MasterUsername)
+	d.Set("availability_zones", aws.StringValueSlice(dbc.AvailabilityZones))
+	d.Set("status", dbc.Status)
+
+	if aws.StringValue(dbc.ActivityStreamStatus) == rds.ActivityStreamStatusStarted {
+		d.Set("activity_stream", []map[string]interface{}{
+			{
+				"mode":                                aws.StringValue(dbc.ActivityStreamMode),
+				"kms_key_id":                          aws.StringValue(dbc.ActivityStreamKmsKeyId),
+				"apply_immediately":                   d.Get("activity_stream.0.apply_immediately"),
+				"engine_native_audit_fields_included": aws.BoolValue(dbc.ActivityStreamEngineNativeAuditFieldsIncluded),
+			},
+		})
+	} else {
+		d.Set("activity_stream", nil)
+	}
+
+	return nil
+}
+
+func resourceAwsRDSAuroraStorageNodeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	clusterID := d.Id()
+
+	if d.HasChange("master_password") {
+		log.Printf("[DEBUG] Modifying RDS Aurora Storage Node cluster (%s) master password", clusterID)
+		_, err := conn.ModifyDBCluster(&rds.ModifyDBClusterInput{
+			DBClusterIdentifier: aws.String(clusterID),
+			MasterUserPassword:  aws.String(d.Get("master_password").(string)),
+			ApplyImmediately:    aws.Bool(true),
+		})
+		if err != nil {
+			return fmt.Errorf("error modifying RDS Aurora Storage Node cluster (%s) master password: %s", clusterID, err)
+		}
+
+		if err := resourceAwsRDSAuroraStorageNodeWaitForClusterAvailable(d.Timeout(schema.TimeoutUpdate), clusterID, conn); err != nil {
+			return err
+		}
+	}
+
+	for i := range d.Get("instance").([]interface{}) {
+		key := fmt.Sprintf("instance.%d.instance_class", i)
+		if d.HasChange(key) {
+			identifier := d.Get(fmt.Sprintf("instance.%d.identifier", i)).(string)
+			log.Printf("[DEBUG] Modifying RDS Aurora Storage Node instance (%s) class", identifier)
+			_, err := conn.ModifyDBInstance(&rds.ModifyDBInstanceInput{
+				DBInstanceIdentifier: aws.String(identifier),
+				DBInstanceClass:      aws.String(d.Get(fmt.Sprintf("instance.%d.instance_class", i)).(string)),
+				ApplyImmediately:     aws.Bool(true),
+			})
+			if err != nil {
+				return fmt.Errorf("error modifying RDS Aurora Storage Node instance (%s): %s", identifier, err)
+			}
+		}
+	}
+
+	if d.HasChange("activity_stream") {
+		// Like the standalone aws_rds_cluster_activity_stream resource, with
+		// apply_immediately=false an existing stream's StopActivityStream is
+		// only scheduled for the cluster's next maintenance window, and the
+		// update returns right after issuing it: it neither waits nor starts
+		// the stream with the new settings. apply_immediately comes from the
+		// new block, or from the old block when the activity_stream block
+		// is cleared entirely.
+		applyImmediately := true
+		if o, n := d.GetChange("activity_stream"); len(n.([]interface{})) > 0 {
+			applyImmediately = n.([]interface{})[0].(map[string]interface{})["apply_immediately"].(bool)
+		} else if len(o.([]interface{})) > 0 {
+			applyImmediately = o.([]interface{})[0].(map[string]interface{})["apply_immediately"].(bool)
+		}
+
+		old, _ := d.GetChange("activity_stream")
+		if len(old.([]interface{})) > 0 {
+			log.Printf("[DEBUG] Stopping RDS Aurora Storage Node activity stream (%s) before updating", clusterID)
+			_, err := conn.StopActivityStream(&rds.StopActivityStreamInput{
+				ApplyImmediately: aws.Bool(applyImmediately),
+				ResourceArn:      aws.String(clusterID),
+			})
+			if err != nil {
+				return fmt.Errorf("error stopping RDS Aurora Storage Node activity stream: %s", err)
+			}
+
+			if !applyImmediately {
+				log.Printf("[DEBUG] RDS Aurora Storage Node (%s) activity stream update deferred to next maintenance window, not waiting for it", clusterID)
+				return resourceAwsRDSAuroraStorageNodeRead(d, meta)
+			}
+
+			if err := resourceAwsRDSClusterActivityStreamWaitForStopped(d.Timeout(schema.TimeoutUpdate), clusterID, conn); err != nil {
+				return err
+			}
+		}
+
+		if streamInput := resourceAwsRDSAuroraStorageNodeActivityStreamInput(d, clusterID); streamInput != nil {
+			log.Printf("[DEBUG] Starting RDS Aurora Storage Node activity stream (%s) with new settings", clusterID)
+			if err := resourceAwsRDSClusterActivityStreamStart(conn, streamInput, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error starting RDS Aurora Storage Node activity stream: %s", err)
+			}
+		}
+	}
+
+	wantStreamStarted := len(d.Get("activity_stream").([]interface{})) > 0
+	if err := resourceAwsRDSAuroraStorageNodeWaitForAvailable(d.Timeout(schema.TimeoutUpdate), clusterID, resourceAwsRDSAuroraStorageNodeInstanceIDs(d), wantStreamStarted, conn); err != nil {
+		return err
+	}
+
+	return resourceAwsRDSAuroraStorageNodeRead(d, meta)
+}
+
+func resourceAwsRDSAuroraStorageNodeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	clusterID := d.Id()
+
+	if len(d.Get("activity_stream").([]interface{})) > 0 {
+		log.Printf("[DEBUG] Stopping RDS Aurora Storage Node activity stream (%s) before destroy", clusterID)
+		_, err := conn.StopActivityStream(&rds.StopActivityStreamInput{
+			ApplyImmediately: aws.Bool(true),
+			ResourceArn:      aws.String(clusterID),
+		})
+		if err != nil && !isAWSErr(err, rds.ErrCodeDBClusterNotFoundFault, "") {
+			return fmt.Errorf("error stopping RDS Aurora Storage Node activity stream: %s", err)
+		}
+		if err == nil {
+			if err := resourceAwsRDSClusterActivityStreamWaitForStopped(d.Timeout(schema.TimeoutDelete), clusterID, conn); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, raw := range d.Get("instance").([]interface{}) {
+		instance := raw.(map[string]interface{})
+		identifier := instance["identifier"].(string)
+
+		log.Printf("[DEBUG] Deleting RDS Aurora Storage Node instance: %s", identifier)
+		_, err := conn.DeleteDBInstance(&rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(identifier),
+			SkipFinalSnapshot:    aws.Bool(true),
+		})
+		if err != nil && !isAWSErr(err, rds.ErrCodeDBInstanceNotFoundFault, "") {
+			return fmt.Errorf("error deleting RDS Aurora Storage Node instance (%s): %s", identifier, err)
+		}
+
+		if err := waitUntilAwsDbInstanceIsDeleted(identifier, conn, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return fmt.Errorf("error waiting for RDS Aurora Storage Node instance (%s) to be deleted: %s", identifier, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Aurora Storage Node cluster: %s", clusterID)
+	_, err := conn.DeleteDBCluster(&rds.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+		SkipFinalSnapshot:   aws.Bool(true),
+	})
+	if err != nil && !isAWSErr(err, rds.ErrCodeDBClusterNotFoundFault, "") {
+		return fmt.Errorf("error deleting RDS Aurora Storage Node cluster (%s): %s", clusterID, err)
+	}
+
+	return waitForRDSClusterDeletion(conn, clusterID, d.Timeout(schema.TimeoutDelete))
+}
+
+// resourceAwsRDSAuroraStorageNodeInstanceIDs returns the configured instance
+// identifiers in topology order.
+func resourceAwsRDSAuroraStorageNodeInstanceIDs(d *schema.ResourceData) []string {
+	instances := d.Get("instance").([]interface{})
+	ids := make([]string, 0, len(instances))
+	for _, raw := range instances {
+		ids = append(ids, raw.(map[string]interface{})["identifier"].(string))
+	}
+	return ids
+}
+
+// resourceAwsRDSAuroraStorageNodeActivityStreamInput builds the
+// StartActivityStream input for the configured activity_stream block, or
+// nil if none is configured.
+func resourceAwsRDSAuroraStorageNodeActivityStreamInput(d *schema.ResourceData, clusterID string) *rds.StartActivityStreamInput {
+	blocks := d.Get("activity_stream").([]interface{})
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	block := blocks[0].(map[string]interface{})
+	return &rds.StartActivityStreamInput{
+		ResourceArn:                     aws.String(clusterID),
+		ApplyImmediately:                aws.Bool(block["apply_immediately"].(bool)),
+		KmsKeyId:                        aws.String(block["kms_key_id"].(string)),
+		Mode:                            aws.String(block["mode"].(string)),
+		EngineNativeAuditFieldsIncluded: aws.Bool(block["engine_native_audit_fields_included"].(bool)),
+	}
+}
+
+// resourceAwsRDSAuroraStorageNodeWaitForClusterAvailable waits for the
+// cluster alone to become available, before any instance exists for
+// waiter.StorageNodeStatus to look at.
+func resourceAwsRDSAuroraStorageNodeWaitForClusterAvailable(timeout time.Duration, clusterID string, conn *rds.RDS) error {
+	log.Printf("Waiting for RDS Aurora Storage Node cluster %s to become available...", clusterID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"creating", "backing-up", "backtracking", "modifying", "preparing-data-migration",
+			"migrating", "maintenance", "renaming", "resetting-master-credentials", "starting",
+			"stopping", "stopped", "upgrading", "update-iam-db-auth",
+		},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			output, err := conn.DescribeDBClusters(&rds.DescribeDBClustersInput{
+				DBClusterIdentifier: aws.String(clusterID),
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			if output == nil || len(output.DBClusters) == 0 {
+				return nil, "", nil
+			}
+			return output.DBClusters[0], aws.StringValue(output.DBClusters[0].Status), nil
+		},
+		Timeout:    timeout,
+		Delay:      AWSRDSAuroraStorageNodeRetryDelay,
+		MinTimeout: AWSRDSAuroraStorageNodeRetryMinTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for RDS Aurora Storage Node cluster (%s) to be available: %v", clusterID, err)
+	}
+	return nil
+}
+
+// resourceAwsRDSAuroraStorageNodeWaitForAvailable waits on the composite
+// waiter.StorageNodeStatus state machine until the cluster and its instances
+// report ready and its activity stream is started (if wantStreamStarted) or
+// stopped (otherwise).
+func resourceAwsRDSAuroraStorageNodeWaitForAvailable(timeout time.Duration, clusterID string, instanceIDs []string, wantStreamStarted bool, conn *rds.RDS) error {
+	log.Printf("Waiting for RDS Aurora Storage Node %s to become available...", clusterID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			// DBCluster transient states
+			"creating", "backing-up", "backtracking", "modifying", "maintenance",
+			"renaming", "resetting-master-credentials", "starting", "stopping",
+			"stopped", "upgrading", "update-iam-db-auth",
+			// DBInstance transient states not already covered above
+			"configuring-enhanced-monitoring", "configuring-iam-database-auth",
+			"configuring-log-exports", "converting-storage-to-encrypted",
+			"insufficient-capacity", "moving-to-vpc", "rebooting",
+			"storage-config-upgrade", "storage-optimization",
+			// StorageNodeStatus composite states
+			waiter.StorageNodeStatusCreatingInstances,
+			waiter.StorageNodeStatusStartingStream,
+			waiter.StorageNodeStatusStoppingStream,
+		},
+		Target:     []string{waiter.StorageNodeStatusAvailable},
+		Refresh:    resource.StateRefreshFunc(waiter.StorageNodeStatus(conn, clusterID, instanceIDs, wantStreamStarted)),
+		Timeout:    timeout,
+		Delay:      AWSRDSAuroraStorageNodeRetryDelay,
+		MinTimeout: AWSRDSAuroraStorageNodeRetryMinTimeout,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("error waiting for RDS Aurora Storage Node (%s) to be available: %v", clusterID, err)
+	}
+	return nil
+}
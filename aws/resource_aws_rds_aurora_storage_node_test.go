@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_rds_aurora_storage_node", &resource.Sweeper{
+		Name: "aws_rds_aurora_storage_node",
+		F:    func(region string) error { return nil },
+	})
+}
+
+func TestAccAWSRDSAuroraStorageNode_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aws_rds_aurora_storage_node.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRDSAuroraStorageNodeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRDSAuroraStorageNodeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRDSAuroraStorageNodeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "instance.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "activity_stream.0.mode", "async"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRDSAuroraStorageNodeExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("RDS Aurora Storage Node cluster ID is not set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+		_, err := conn.DescribeDBClusters(&rds.DescribeDBClustersInput{
+			DBClusterIdentifier: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSRDSAuroraStorageNodeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rds_aurora_storage_node" {
+			continue
+		}
+
+		_, err := conn.DescribeDBClusters(&rds.DescribeDBClustersInput{
+			DBClusterIdentifier: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, rds.ErrCodeDBClusterNotFoundFault, "") {
+			continue
+		}
+		if err == nil {
+			return fmt.Errorf("RDS Aurora Storage Node cluster %s still exists", rs.Primary.ID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func testAccAWSRDSAuroraStorageNodeConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_kms_key" "test" {
+  description             = "tf-testacc-kms-key-%[1]s"
+  deletion_window_in_days = 7
+}
+
+resource "aws_rds_aurora_storage_node" "test" {
+  cluster_identifier = "tf-testacc-aurora-cluster-%[1]s"
+  engine              = "aurora-postgresql"
+  engine_version      = "10.11"
+  database_name       = "mydb"
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  availability_zones  = ["${data.aws_availability_zones.available.names[0]}", "${data.aws_availability_zones.available.names[1]}", "${data.aws_availability_zones.available.names[2]}"]
+
+  instance {
+    identifier     = "tf-testacc-aurora-instance-%[1]s"
+    instance_class = "db.r5.large"
+  }
+
+  activity_stream {
+    mode       = "async"
+    kms_key_id = "${aws_kms_key.test.key_id}"
+  }
+}
+`, rName)
+}
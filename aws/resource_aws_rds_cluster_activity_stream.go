@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-aws/aws/internal/service/rds/finder"
 )
 
 const (
@@ -29,6 +31,7 @@ func resourceAwsRDSClusterActivityStream() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
 			Delete: schema.DefaultTimeout(120 * time.Minute),
 		},
 
@@ -46,16 +49,16 @@ func resourceAwsRDSClusterActivityStream() *schema.Resource {
 			"kms_key_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"mode": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"sync",
-					"async",
-				}, false),
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(rds.ActivityStreamMode_Values(), false),
+			},
+			"engine_native_audit_fields_included": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"kinesis_stream_name": {
 				Type:     schema.TypeString,
@@ -65,27 +68,17 @@ func resourceAwsRDSClusterActivityStream() *schema.Resource {
 	}
 }
 
-func resourceAwsRDSClusterActivityStreamCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
-
-	resourceArn := d.Get("arn").(string)
-	applyImmediately := d.Get("apply_immediately").(bool)
-	kmsKeyId := d.Get("kms_key_id").(string)
-	mode := d.Get("mode").(string)
-
-	startActivityStreamInput := &rds.StartActivityStreamInput{
-		ResourceArn:      aws.String(resourceArn),
-		ApplyImmediately: aws.Bool(applyImmediately),
-		KmsKeyId:         aws.String(kmsKeyId),
-		Mode:             aws.String(mode),
-	}
-
-	log.Printf("[DEBUG] RDS Cluster start activity stream input: %s", startActivityStreamInput)
+// resourceAwsRDSClusterActivityStreamStart calls StartActivityStream,
+// retrying on the InvalidParameterCombination error AWS returns transiently
+// right after a dependent state change (e.g. the cluster coming up, or the
+// stream having just been stopped).
+func resourceAwsRDSClusterActivityStreamStart(conn *rds.RDS, input *rds.StartActivityStreamInput, timeout time.Duration) error {
+	log.Printf("[DEBUG] RDS Cluster start activity stream input: %s", input)
 
 	var resp *rds.StartActivityStreamOutput
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.Retry(timeout, func() *resource.RetryError {
 		var err error
-		resp, err = conn.StartActivityStream(startActivityStreamInput)
+		resp, err = conn.StartActivityStream(input)
 		if err != nil {
 			if isAWSErr(err, "InvalidParameterCombination", "Activity Streams is not supported for this configuration") {
 				log.Printf("[DEBUG] Occur Error: InvalidParameterCombination, will retring...")
@@ -98,18 +91,41 @@ func resourceAwsRDSClusterActivityStreamCreate(d *schema.ResourceData, meta inte
 	})
 
 	if isResourceTimeoutError(err) {
-		resp, err = conn.StartActivityStream(startActivityStreamInput)
+		resp, err = conn.StartActivityStream(input)
 	}
 
 	if err != nil {
-		return fmt.Errorf("error creating RDS Cluster Activity Stream: %s", err)
+		return err
 	}
 
 	log.Printf("[DEBUG]: RDS Cluster start activity stream response: %s", resp)
 
+	return nil
+}
+
+func resourceAwsRDSClusterActivityStreamCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	resourceArn := d.Get("arn").(string)
+	applyImmediately := d.Get("apply_immediately").(bool)
+	kmsKeyId := d.Get("kms_key_id").(string)
+	mode := d.Get("mode").(string)
+
+	startActivityStreamInput := &rds.StartActivityStreamInput{
+		ResourceArn:                     aws.String(resourceArn),
+		ApplyImmediately:                aws.Bool(applyImmediately),
+		KmsKeyId:                        aws.String(kmsKeyId),
+		Mode:                            aws.String(mode),
+		EngineNativeAuditFieldsIncluded: aws.Bool(d.Get("engine_native_audit_fields_included").(bool)),
+	}
+
+	if err := resourceAwsRDSClusterActivityStreamStart(conn, startActivityStreamInput, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error creating RDS Cluster Activity Stream: %s", err)
+	}
+
 	d.SetId(resourceArn)
 
-	err = resourceAwsRDSClusterActivityStreamWaitForStarted(d.Timeout(schema.TimeoutCreate), d.Id(), conn)
+	err := resourceAwsRDSClusterActivityStreamWaitForStarted(d.Timeout(schema.TimeoutCreate), d.Id(), conn)
 	if err != nil {
 		return err
 	}
@@ -120,35 +136,11 @@ func resourceAwsRDSClusterActivityStreamCreate(d *schema.ResourceData, meta inte
 func resourceAwsRDSClusterActivityStreamRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
 
-	input := &rds.DescribeDBClustersInput{
-		DBClusterIdentifier: aws.String(d.Id()),
-	}
-
-	log.Printf("[DEBUG] Describing RDS Cluster: %s", input)
-	resp, err := conn.DescribeDBClusters(input)
-
-	if isAWSErr(err, rds.ErrCodeDBClusterNotFoundFault, "") {
-		log.Printf("[WARN] RDS Cluster (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
-	}
-
+	dbc, err := finder.ActivityStreamByClusterIdentifier(conn, d.Id())
 	if err != nil {
 		return fmt.Errorf("error describing RDS Cluster (%s): %s", d.Id(), err)
 	}
 
-	if resp == nil {
-		return fmt.Errorf("error retrieving RDS cluster: empty response for: %s", input)
-	}
-
-	var dbc *rds.DBCluster
-	for _, c := range resp.DBClusters {
-		if aws.StringValue(c.DBClusterArn) == d.Id() {
-			dbc = c
-			break
-		}
-	}
-
 	if dbc == nil {
 		log.Printf("[WARN] RDS Cluster (%s) not found, removing from state", d.Id())
 		d.SetId("")
@@ -159,21 +151,53 @@ func resourceAwsRDSClusterActivityStreamRead(d *schema.ResourceData, meta interf
 	d.Set("kms_key_id", dbc.ActivityStreamKmsKeyId)
 	d.Set("kinesis_stream_name", dbc.ActivityStreamKinesisStreamName)
 	d.Set("mode", dbc.ActivityStreamMode)
+	d.Set("engine_native_audit_fields_included", dbc.ActivityStreamEngineNativeAuditFieldsIncluded)
 
 	return nil
 }
 
 func resourceAwsRDSClusterActivityStreamUpdate(d *schema.ResourceData, meta interface{}) error {
-	if d.HasChange("arn") || d.HasChange("apply_immediately") || d.HasChange("kms_key_id") || d.HasChange("mode") {
-		log.Printf("[DEBUG] Stopping RDS Cluster Activity Stream before updating")
-		err := resourceAwsRDSClusterActivityStreamDelete(d, meta)
+	conn := meta.(*AWSClient).rdsconn
+
+	if d.HasChange("kms_key_id") || d.HasChange("mode") || d.HasChange("engine_native_audit_fields_included") {
+		// Like every other RDS ApplyImmediately flag, this one defers: with
+		// apply_immediately=false, StopActivityStream is only scheduled for
+		// the cluster's next maintenance window rather than applied right
+		// away, and Update returns right after issuing it: it neither waits
+		// nor calls StartActivityStream with the new settings.
+		applyImmediately := d.Get("apply_immediately").(bool)
+
+		log.Printf("[DEBUG] Stopping RDS Cluster Activity Stream (%s) to apply new mode/kms_key_id", d.Id())
+		_, err := conn.StopActivityStream(&rds.StopActivityStreamInput{
+			ApplyImmediately: aws.Bool(applyImmediately),
+			ResourceArn:      aws.String(d.Id()),
+		})
 		if err != nil {
+			return fmt.Errorf("error stopping RDS Cluster Activity Stream: %s", err)
+		}
+
+		if !applyImmediately {
+			log.Printf("[DEBUG] RDS Cluster Activity Stream (%s) update deferred to next maintenance window, not waiting for it", d.Id())
+			return resourceAwsRDSClusterActivityStreamRead(d, meta)
+		}
+
+		if err := resourceAwsRDSClusterActivityStreamWaitForStopped(d.Timeout(schema.TimeoutUpdate), d.Id(), conn); err != nil {
 			return err
 		}
 
-		log.Printf("[DEBUG] Starting RDS Cluster Activity Stream")
-		err = resourceAwsRDSClusterActivityStreamCreate(d, meta)
-		if err != nil {
+		startActivityStreamInput := &rds.StartActivityStreamInput{
+			ResourceArn:                     aws.String(d.Id()),
+			ApplyImmediately:                aws.Bool(applyImmediately),
+			KmsKeyId:                        aws.String(d.Get("kms_key_id").(string)),
+			Mode:                            aws.String(d.Get("mode").(string)),
+			EngineNativeAuditFieldsIncluded: aws.Bool(d.Get("engine_native_audit_fields_included").(bool)),
+		}
+
+		if err := resourceAwsRDSClusterActivityStreamStart(conn, startActivityStreamInput, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error starting RDS Cluster Activity Stream: %s", err)
+		}
+
+		if err := resourceAwsRDSClusterActivityStreamWaitForStarted(d.Timeout(schema.TimeoutUpdate), d.Id(), conn); err != nil {
 			return err
 		}
 	}
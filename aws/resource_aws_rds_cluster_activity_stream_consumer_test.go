@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_rds_cluster_activity_stream_consumer", &resource.Sweeper{
+		Name: "aws_rds_cluster_activity_stream_consumer",
+		F:    func(region string) error { return nil },
+		Dependencies: []string{
+			"aws_rds_cluster_activity_stream",
+		},
+	})
+}
+
+func TestAccAWSRDSClusterActivityStreamConsumer_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resourceName := "aws_rds_cluster_activity_stream_consumer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRDSClusterActivityStreamConsumerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRDSClusterActivityStreamConsumerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRDSClusterActivityStreamConsumerExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", kinesis.ConsumerStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRDSClusterActivityStreamConsumerExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("RDS Cluster Activity Stream Consumer ARN is not set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+		_, err := conn.DescribeStreamConsumer(&kinesis.DescribeStreamConsumerInput{
+			ConsumerARN: aws.String(rs.Primary.ID),
+		})
+		return err
+	}
+}
+
+func testAccCheckAWSRDSClusterActivityStreamConsumerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rds_cluster_activity_stream_consumer" {
+			continue
+		}
+
+		_, err := conn.DescribeStreamConsumer(&kinesis.DescribeStreamConsumerInput{
+			ConsumerARN: aws.String(rs.Primary.ID),
+		})
+		if isAWSErr(err, kinesis.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err == nil {
+			return fmt.Errorf("RDS Cluster Activity Stream Consumer %s still exists", rs.Primary.ID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func testAccAWSRDSClusterActivityStreamConsumerConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = "tf-testacc-kms-key-%[1]s"
+  deletion_window_in_days = 7
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier  = "tf-testacc-aurora-cluster-%[1]s"
+  engine              = "aurora-postgresql"
+  master_username     = "foo"
+  master_password     = "mustbeeightcharaters"
+  skip_final_snapshot = true
+}
+
+resource "aws_rds_cluster_activity_stream" "test" {
+  arn               = aws_rds_cluster.test.arn
+  mode              = "async"
+  kms_key_id        = aws_kms_key.test.key_id
+  apply_immediately = true
+}
+
+resource "aws_rds_cluster_activity_stream_consumer" "test" {
+  name       = "tf-testacc-activity-stream-consumer-%[1]s"
+  stream_arn = "arn:${data.aws_partition.current.partition}:kinesis:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:stream/aws-rds-das-${aws_rds_cluster.test.cluster_resource_id}"
+
+  depends_on = [aws_rds_cluster_activity_stream.test]
+}
+
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+`, rName)
+}
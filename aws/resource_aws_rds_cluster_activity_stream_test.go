@@ -58,6 +58,64 @@ func TestAccAWSRDSClusterActivityStream_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSRDSClusterActivityStream_updateMode(t *testing.T) {
+	var dbCluster rds.DBCluster
+	rName := acctest.RandString(5)
+	resourceName := "aws_rds_cluster_activity_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSClusterActivityStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSClusterActivityStreamConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRDSClusterActivityStreamExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "mode", "async"),
+				),
+			},
+			{
+				Config: testAccAWSClusterActivityStreamConfigMode(rName, "sync"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRDSClusterActivityStreamExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "mode", "sync"),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", "aws_rds_cluster.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRDSClusterActivityStream_auroraMysql(t *testing.T) {
+	var dbCluster rds.DBCluster
+	rName := acctest.RandString(5)
+	resourceName := "aws_rds_cluster_activity_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSClusterActivityStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSClusterActivityStreamConfigAuroraMysql(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRDSClusterActivityStreamExists(resourceName, &dbCluster),
+					resource.TestCheckResourceAttrSet(resourceName, "kinesis_stream_name"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "async"),
+					resource.TestCheckResourceAttr(resourceName, "engine_native_audit_fields_included", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_immediately"},
+			},
+		},
+	})
+}
+
 func TestAccAWSRDSClusterActivityStream_disappears(t *testing.T) {
 	var dbCluster rds.DBCluster
 	rName := acctest.RandString(5)
@@ -133,8 +191,15 @@ func testAccCheckAWSRDSClusterActivityStreamAttributes(v *rds.DBCluster) resourc
 			return fmt.Errorf("incorrect activity stream status: expected: %s, got: %s", rds.ActivityStreamStatusStarted, aws.StringValue(v.ActivityStreamStatus))
 		}
 
-		if aws.StringValue(v.ActivityStreamMode) != "sync" && aws.StringValue(v.ActivityStreamMode) != "async" {
-			return fmt.Errorf("incorrect activity stream mode: expected: sync or async, got: %s", aws.StringValue(v.ActivityStreamMode))
+		modeFound := false
+		for _, mode := range rds.ActivityStreamMode_Values() {
+			if aws.StringValue(v.ActivityStreamMode) == mode {
+				modeFound = true
+				break
+			}
+		}
+		if !modeFound {
+			return fmt.Errorf("incorrect activity stream mode: expected one of %v, got: %s", rds.ActivityStreamMode_Values(), aws.StringValue(v.ActivityStreamMode))
 		}
 
 		return nil
@@ -219,6 +284,91 @@ resource "aws_rds_cluster_activity_stream" "test" {
 `, rName)
 }
 
+func testAccAWSClusterActivityStreamConfigMode(rName, mode string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_kms_key" "test" {
+	description             = "tf-testacc-kms-key-%[1]s"
+  deletion_window_in_days = 7
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier              = "tf-testacc-aurora-cluster-%[1]s"
+  engine                  				= "aurora-postgresql"
+  engine_version                  = "10.11"
+	availability_zones  						= ["${data.aws_availability_zones.available.names[0]}", "${data.aws_availability_zones.available.names[1]}", "${data.aws_availability_zones.available.names[2]}"]
+  database_name                   = "mydb"
+  master_username                 = "foo"
+  master_password                 = "mustbeeightcharaters"
+  db_cluster_parameter_group_name = "default.aurora-postgresql10"
+  skip_final_snapshot             = true
+  deletion_protection             = false
+}
+
+resource "aws_rds_cluster_instance" "test" {
+	identifier         = "tf-testacc-aurora-instance-%[1]s"
+  cluster_identifier = "${aws_rds_cluster.test.cluster_identifier}"
+  engine             = "${aws_rds_cluster.test.engine}"
+  instance_class     = "db.r5.large"
+}
+
+resource "aws_rds_cluster_activity_stream" "test" {
+  arn  								= "${aws_rds_cluster.test.arn}"
+  apply_immediately  	= true
+  kms_key_id 					= "${aws_kms_key.test.key_id}"
+  mode         				= "%[2]s"
+
+	depends_on = ["aws_rds_cluster.test", "aws_rds_cluster_instance.test"]
+}
+`, rName, mode)
+}
+
+func testAccAWSClusterActivityStreamConfigAuroraMysql(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_kms_key" "test" {
+	description             = "tf-testacc-kms-key-%[1]s"
+  deletion_window_in_days = 7
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier              = "tf-testacc-aurora-cluster-%[1]s"
+  engine                  				= "aurora-mysql"
+  engine_version                  = "5.7.mysql_aurora.2.09.2"
+	availability_zones  						= ["${data.aws_availability_zones.available.names[0]}", "${data.aws_availability_zones.available.names[1]}", "${data.aws_availability_zones.available.names[2]}"]
+  database_name                   = "mydb"
+  master_username                 = "foo"
+  master_password                 = "mustbeeightcharaters"
+  db_cluster_parameter_group_name = "default.aurora-mysql5.7"
+  skip_final_snapshot             = true
+  deletion_protection             = false
+}
+
+resource "aws_rds_cluster_instance" "test" {
+	identifier         = "tf-testacc-aurora-instance-%[1]s"
+  cluster_identifier = "${aws_rds_cluster.test.cluster_identifier}"
+  engine             = "${aws_rds_cluster.test.engine}"
+  instance_class     = "db.r5.large"
+}
+
+resource "aws_rds_cluster_activity_stream" "test" {
+  arn  								                = "${aws_rds_cluster.test.arn}"
+  apply_immediately  	                = true
+  kms_key_id 					                = "${aws_kms_key.test.key_id}"
+  mode         				                = "async"
+  engine_native_audit_fields_included = true
+
+	depends_on = ["aws_rds_cluster.test", "aws_rds_cluster_instance.test"]
+}
+`, rName)
+}
+
 func testAccCheckAWSRDSClusterActivityStreamDisappears(v *rds.DBCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).rdsconn